@@ -1,7 +1,9 @@
 package main
 
 import (
+	"bytes"
 	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -9,6 +11,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -21,14 +24,22 @@ import (
 	"github.com/knadh/koanf/providers/file"
 	"github.com/knadh/koanf/v2"
 
+	"github.com/cloudflare/cloudflare-go"
+
 	"github.com/gregdel/pushover"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 const DEFAULT_PORT int = 8080
 const DEFAULT_LOG_LEVEL zerolog.Level = zerolog.DebugLevel
+const DEFAULT_AUDIT_LOG_SIZE int = 100
 
 const CONFIG_FILE_PATH string = "config.yaml"
 const ENV_PREFIX string = "UNIFI_R53_DNS_"
@@ -47,6 +58,20 @@ type HostConfig struct {
 	ZoneId          string   `koanf:"zoneId"`
 	Ttl             int64    `koanf:"ttl"`
 	AdditionalHosts []string `koanf:"additionalHosts"`
+	EnableA         bool     `koanf:"enableA"`
+	EnableAAAA      bool     `koanf:"enableAAAA"`
+	Provider        string   `koanf:"provider"`
+	Token           string   `koanf:"token"`
+}
+
+// BasicAuthConfig configures HTTP Basic Auth for /nic/update, compatible
+// with the dyndns2 protocol UniFi's custom DNS provider speaks natively.
+// PasswordHash (a bcrypt hash) is preferred over storing Password in
+// plaintext.
+type BasicAuthConfig struct {
+	Username     string `koanf:"username"`
+	Password     string `koanf:"password"`
+	PasswordHash string `koanf:"passwordHash"`
 }
 
 type PushoverConfig struct {
@@ -54,17 +79,42 @@ type PushoverConfig struct {
 	RecipientKey string `koanf:"user-key"`
 }
 
+type CloudflareConfig struct {
+	ApiToken string `koanf:"apiToken"`
+}
+
+type NotificationChannelConfig struct {
+	Type     string `koanf:"type"`     // pushover|slack|discord|ntfy|webhook
+	Severity string `koanf:"severity"` // "always" (default) or "error"
+	Url      string `koanf:"url"`
+	ApiToken string `koanf:"api-token"`
+	UserKey  string `koanf:"user-key"`
+}
+
+type NotificationsConfig struct {
+	Channels            []NotificationChannelConfig `koanf:"channels"`
+	DedupeWindowSeconds int                         `koanf:"dedupeWindowSeconds"`
+}
+
 type AppConfig struct {
 	App struct {
-		Port int `koanf:"port"`
+		Port           int             `koanf:"port"`
+		BasicAuth      BasicAuthConfig `koanf:"basicAuth"`
+		TrustedProxies []string        `koanf:"trustedProxies"`
+		AuditLogSize   int             `koanf:"auditLogSize"`
 	} `koanf:"app"`
-	Records  map[string]HostConfig `koanf:"records"`
-	Pushover PushoverConfig        `koanf:"pushover"`
+	Records       map[string]HostConfig `koanf:"records"`
+	Pushover      PushoverConfig        `koanf:"pushover"`
+	Cloudflare    CloudflareConfig      `koanf:"cloudflare"`
+	Notifications NotificationsConfig   `koanf:"notifications"`
 }
 
+const DefaultProvider = "route53"
+
 type UpdateRequest struct {
 	Host   string
 	IP     string
+	IPv6   string
 	Commit bool
 }
 
@@ -72,21 +122,75 @@ func (r *UpdateRequest) getHostname() Hostname {
 	return Hostname(r.Host)
 }
 
-func (r *UpdateRequest) validateIp() (net.IP, error) {
+// validateIPv4 parses the request's IP field as an IPv4 address. An empty
+// IP field is not an error; it means no IPv4 update was requested.
+func (r *UpdateRequest) validateIPv4() (net.IP, error) {
+	if r.IP == "" {
+		return nil, nil
+	}
 	ip := net.ParseIP(r.IP).To4()
 	if ip == nil {
-		return nil, fmt.Errorf("'%s' is not a valid IpV4 address", r.IP)
+		return nil, fmt.Errorf("'%s' is not a valid IPv4 address", r.IP)
 	}
 	return ip, nil
 }
 
-func NotifyPushover(config PushoverConfig, host string, err error) {
-	if config.ApiToken == "" || config.RecipientKey == "" {
-		log.Info().Msg("Pushover notification not configured.")
-		return
+// validateIPv6 parses the request's IPv6 field as an IPv6 address. An empty
+// IPv6 field is not an error; it means no IPv6 update was requested.
+func (r *UpdateRequest) validateIPv6() (net.IP, error) {
+	if r.IPv6 == "" {
+		return nil, nil
+	}
+	ip := net.ParseIP(r.IPv6)
+	if ip == nil || ip.To4() != nil {
+		return nil, fmt.Errorf("'%s' is not a valid IPv6 address", r.IPv6)
+	}
+	return ip, nil
+}
+
+// resolveOpenDNS resolves the caller's public IP address of the given
+// family ("ip4" or "ip6") by querying myip.opendns.com against OpenDNS's
+// resolver, mirroring the `dig +short myip.opendns.com @resolver1.opendns.com`
+// idiom used by the companion r53 cmd's digip.OpenDNS helper.
+func resolveOpenDNS(ctx context.Context, network string) (net.IP, error) {
+	udpNetwork := "udp4"
+	if network == "ip6" {
+		udpNetwork = "udp6"
+	}
+	resolver := &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			d := net.Dialer{Timeout: 5 * time.Second}
+			return d.DialContext(ctx, udpNetwork, "resolver1.opendns.com:53")
+		},
 	}
-	app := pushover.New(config.ApiToken)
-	recipient := pushover.NewRecipient(config.RecipientKey)
+	ips, err := resolver.LookupIP(ctx, network, "myip.opendns.com")
+	if err != nil || len(ips) == 0 {
+		return nil, fmt.Errorf("failed to resolve public IP via OpenDNS: %w", err)
+	}
+	return ips[0], nil
+}
+
+// Notifier abstracts a single notification backend, so an IP update can
+// fan out to any configured combination of Pushover, Slack, Discord,
+// ntfy.sh, or a generic JSON webhook.
+type Notifier interface {
+	Name() string
+	Notify(ctx context.Context, host string, err error) error
+}
+
+type pushoverNotifier struct {
+	config PushoverConfig
+}
+
+func (n *pushoverNotifier) Name() string { return "pushover" }
+
+func (n *pushoverNotifier) Notify(ctx context.Context, host string, err error) error {
+	if n.config.ApiToken == "" || n.config.RecipientKey == "" {
+		return nil
+	}
+	app := pushover.New(n.config.ApiToken)
+	recipient := pushover.NewRecipient(n.config.RecipientKey)
 	msgStr := "IP Updated for: " + host
 	if err != nil {
 		msgStr = "Error: IP Update Failed for: " + host
@@ -95,103 +199,886 @@ func NotifyPushover(config PushoverConfig, host string, err error) {
 		Message:  msgStr,
 		Priority: pushover.PriorityNormal,
 	}
+	response, sendErr := app.SendMessage(message, recipient)
+	if sendErr != nil {
+		return sendErr
+	}
+	log.Debug().Interface("PushoverResponse", response).Msg("Pushover Response")
+	return nil
+}
+
+// httpNotifier covers the notification backends that are just a POST to a
+// URL with a backend-specific payload shape: Slack and Discord incoming
+// webhooks, ntfy.sh, and a generic JSON webhook.
+type httpNotifier struct {
+	kind string
+	url  string
+}
+
+func (n *httpNotifier) Name() string { return n.kind }
 
-	// Send the message to the recipient
-	response, err := app.SendMessage(message, recipient)
+func (n *httpNotifier) Notify(ctx context.Context, host string, err error) error {
+	message := "IP Updated for: " + host
 	if err != nil {
-		log.Error().Err(err).
-			Msg("Error publishing Pushover Notification")
+		message = "Error: IP Update Failed for: " + host
 	}
-	log.Info().
-		Interface("PushoverResponse", response).
-		Msg("Pushover Response")
+
+	var contentType string
+	var body []byte
+	switch n.kind {
+	case "slack":
+		contentType = "application/json"
+		body, _ = json.Marshal(map[string]string{"text": message})
+	case "discord":
+		contentType = "application/json"
+		body, _ = json.Marshal(map[string]string{"content": message})
+	case "ntfy":
+		contentType = "text/plain"
+		body = []byte(message)
+	default: // generic webhook
+		errMsg := ""
+		if err != nil {
+			errMsg = err.Error()
+		}
+		contentType = "application/json"
+		body, _ = json.Marshal(map[string]string{"host": host, "message": message, "error": errMsg})
+	}
+
+	req, reqErr := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if reqErr != nil {
+		return reqErr
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, doErr := http.DefaultClient.Do(req)
+	if doErr != nil {
+		return doErr
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("%s notification failed with status %d", n.kind, resp.StatusCode)
+	}
+	return nil
+}
+
+// notifierEntry pairs a Notifier with the severity filter configured for
+// it: "always" fires on every update, "error" only fires when the update
+// failed.
+type notifierEntry struct {
+	notifier Notifier
+	severity string
+}
+
+func BuildNotifiers(config NotificationsConfig) []notifierEntry {
+	entries := make([]notifierEntry, 0, len(config.Channels))
+	for _, channel := range config.Channels {
+		severity := channel.Severity
+		if severity == "" {
+			severity = "always"
+		}
+		var notifier Notifier
+		switch channel.Type {
+		case "pushover":
+			notifier = &pushoverNotifier{config: PushoverConfig{ApiToken: channel.ApiToken, RecipientKey: channel.UserKey}}
+		case "slack", "discord", "ntfy", "webhook":
+			notifier = &httpNotifier{kind: channel.Type, url: channel.Url}
+		default:
+			log.Warn().Str("type", channel.Type).Msg("Unknown notification channel type, ignoring")
+			continue
+		}
+		entries = append(entries, notifierEntry{notifier: notifier, severity: severity})
+	}
+	return entries
+}
+
+// notificationDedupe suppresses repeat notifications for the same
+// host+message within a configured window, so a UniFi controller that
+// re-posts an unchanged IP doesn't spam every notification channel.
+type notificationDedupe struct {
+	mu       sync.Mutex
+	lastSent map[string]time.Time
+}
+
+func newNotificationDedupe() *notificationDedupe {
+	return &notificationDedupe{lastSent: make(map[string]time.Time)}
+}
+
+func (d *notificationDedupe) allow(host string, message string, window time.Duration) bool {
+	if window <= 0 {
+		return true
+	}
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	key := host + "|" + message
+	if last, ok := d.lastSent[key]; ok && time.Since(last) < window {
+		return false
+	}
+	d.lastSent[key] = time.Now()
+	return true
 }
 
-func MakeChangeRequest(zoneId ZoneID, hostname Hostname, ip net.IP, ttl TTL) route53.ChangeResourceRecordSetsInput {
+// Notifications holds the configured notifier fan-out and dedup state for
+// the life of the process.
+type Notifications struct {
+	entries      []notifierEntry
+	dedupe       *notificationDedupe
+	dedupeWindow time.Duration
+}
+
+type notificationResult struct {
+	Channel string
+	Err     error
+}
+
+// notify fires every configured notifier concurrently, honoring each
+// channel's severity filter and the shared dedup window, and logs the
+// aggregate result. value identifies what changed (e.g. the new IP), so
+// that the dedup window only suppresses repeats of the same outcome
+// rather than every notification for a host.
+func (n *Notifications) notify(ctx context.Context, host string, value string, err error) {
+	message := "IP Updated"
+	if err != nil {
+		message = "IP Update Failed: " + err.Error()
+	}
+	dedupeKey := message + "|" + value
+	if !n.dedupe.allow(host, dedupeKey, n.dedupeWindow) {
+		log.Debug().Str("host", host).Msg("Suppressing duplicate notification")
+		return
+	}
+
+	results := make([]notificationResult, len(n.entries))
+	var wg sync.WaitGroup
+	for i, entry := range n.entries {
+		if err == nil && entry.severity == "error" {
+			continue
+		}
+		wg.Add(1)
+		go func(i int, entry notifierEntry) {
+			defer wg.Done()
+			notifyErr := entry.notifier.Notify(ctx, host, err)
+			results[i] = notificationResult{Channel: entry.notifier.Name(), Err: notifyErr}
+			resultLabel := "success"
+			if notifyErr != nil {
+				resultLabel = "error"
+				log.Error().Err(notifyErr).Str("channel", entry.notifier.Name()).Str("host", host).Msg("Notification failed")
+			}
+			notificationTotal.WithLabelValues(entry.notifier.Name(), resultLabel).Inc()
+		}(i, entry)
+	}
+	wg.Wait()
+	log.Info().Interface("results", results).Str("host", host).Msg("Notification dispatch complete")
+}
+
+// Prometheus metrics, registered on package init and scraped via /metrics.
+var (
+	updatesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "unifi_r53_updates_total",
+		Help: "Total number of DNS update attempts, by host and result.",
+	}, []string{"host", "result"})
+
+	route53ApiDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: "unifi_r53_route53_api_duration_seconds",
+		Help: "Duration of Route53 ChangeResourceRecordSets API calls.",
+	})
+
+	notificationTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "unifi_r53_notification_total",
+		Help: "Total number of notifications sent, by backend and result.",
+	}, []string{"backend", "result"})
+
+	lastUpdateTimestamp = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "unifi_r53_last_update_timestamp_seconds",
+		Help: "Unix timestamp of the last successful update, by host.",
+	}, []string{"host"})
+
+	currentIPInfo = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "unifi_r53_current_ip_info",
+		Help: "Info metric (always 1) recording the current IP applied for a host.",
+	}, []string{"host", "ip"})
+)
+
+func init() {
+	prometheus.MustRegister(updatesTotal, route53ApiDuration, notificationTotal, lastUpdateTimestamp, currentIPInfo)
+}
+
+// ipInfoTracker keeps unifi_r53_current_ip_info accurate by deleting the
+// previous (host, ip) series whenever a host's address for a given family
+// changes, instead of accumulating stale series forever.
+type ipInfoTracker struct {
+	mu   sync.Mutex
+	last map[string]string
+}
+
+var currentIPInfoTracker = &ipInfoTracker{last: make(map[string]string)}
+
+func (t *ipInfoTracker) set(host string, family DesiredRecordType, ip string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := host + "/" + string(family)
+	if prev, ok := t.last[key]; ok && prev != ip {
+		currentIPInfo.DeleteLabelValues(host, prev)
+	}
+	t.last[key] = ip
+	currentIPInfo.WithLabelValues(host, ip).Set(1)
+}
+
+// AuditEntry is one recorded /nic/update request, as exposed by /audit.
+type AuditEntry struct {
+	Timestamp        time.Time `json:"timestamp"`
+	Host             string    `json:"host"`
+	IP               string    `json:"ip"`
+	IPv6             string    `json:"ipv6,omitempty"`
+	Commit           bool      `json:"commit"`
+	Provider         string    `json:"provider,omitempty"`
+	Result           string    `json:"result"`
+	Error            string    `json:"error,omitempty"`
+	ProviderResponse string    `json:"providerResponse,omitempty"`
+}
+
+// auditLog is a fixed-size ring buffer of the most recent AuditEntry
+// records, so operators can inspect recent update activity without
+// tailing container logs.
+type auditLog struct {
+	mu       sync.Mutex
+	entries  []AuditEntry
+	next     int
+	wrapped  bool
+	capacity int
+}
+
+func newAuditLog(capacity int) *auditLog {
+	return &auditLog{entries: make([]AuditEntry, capacity), capacity: capacity}
+}
+
+func (a *auditLog) record(entry AuditEntry) {
+	if a.capacity == 0 {
+		return
+	}
+	entry.Timestamp = time.Now()
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.entries[a.next] = entry
+	a.next = (a.next + 1) % a.capacity
+	if a.next == 0 {
+		a.wrapped = true
+	}
+}
+
+// snapshot returns the buffered entries in chronological order.
+func (a *auditLog) snapshot() []AuditEntry {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.wrapped {
+		out := make([]AuditEntry, a.next)
+		copy(out, a.entries[:a.next])
+		return out
+	}
+	out := make([]AuditEntry, a.capacity)
+	copy(out, a.entries[a.next:])
+	copy(out[a.capacity-a.next:], a.entries[:a.next])
+	return out
+}
+
+func makeResourceRecordChange(hostname Hostname, rrType types.RRType, ttl TTL, value string) types.Change {
 	resourceHostName := string(hostname) + "."
 	resourceTtl := int64(ttl)
-	resourceZoneId := string(zoneId)
-	resourceIp := ip.String()
-	comment := "Unifi Updated IP Address"
-	lastUpdatedMsg := fmt.Sprintf("\"Last Updated: %s\"", time.Now().String())
-	return route53.ChangeResourceRecordSetsInput{
-		ChangeBatch: &types.ChangeBatch{
-			Changes: []types.Change{
-				{
-					Action: types.ChangeActionUpsert,
-					ResourceRecordSet: &types.ResourceRecordSet{
-						Name: &resourceHostName,
-						Type: types.RRTypeA,
-						TTL:  &resourceTtl,
-						ResourceRecords: []types.ResourceRecord{
-							{Value: &resourceIp},
-						},
-					},
-				},
-				{
-					Action: types.ChangeActionUpsert,
-					ResourceRecordSet: &types.ResourceRecordSet{
-						Name: &resourceHostName,
-						Type: types.RRTypeTxt,
-						TTL:  &resourceTtl,
-						ResourceRecords: []types.ResourceRecord{
-							{Value: &lastUpdatedMsg},
-						},
-					},
-				},
+	resourceValue := value
+	return types.Change{
+		Action: types.ChangeActionUpsert,
+		ResourceRecordSet: &types.ResourceRecordSet{
+			Name: &resourceHostName,
+			Type: rrType,
+			TTL:  &resourceTtl,
+			ResourceRecords: []types.ResourceRecord{
+				{Value: &resourceValue},
 			},
-			Comment: &comment,
 		},
-		HostedZoneId: &resourceZoneId,
 	}
 }
 
-func UpdateRoute53Record(client route53.Client, hostConfig HostConfig, hostname Hostname, ip net.IP, commit bool) error {
-	zoneId := ZoneID(hostConfig.ZoneId)
-	ttl := TTL(hostConfig.Ttl)
-	input := MakeChangeRequest(zoneId, hostname, ip, ttl)
-	log.Debug().Interface("ChangeResourcesRecordSetInput", input).Send()
+// Route53's documented ChangeResourceRecordSets limits: at most this many
+// change operations per batch (an UPSERT counts as a DELETE plus a
+// CREATE), and at most this many characters across all record values.
+const maxChangesPerBatch = 1000
+const maxBatchValueChars = 32000
+
+type changeCost struct {
+	ops   int
+	chars int
+}
+
+func costOf(change types.Change) changeCost {
+	cost := changeCost{ops: 1}
+	if change.Action == types.ChangeActionUpsert {
+		cost.ops = 2
+	}
+	if change.ResourceRecordSet != nil {
+		for _, rr := range change.ResourceRecordSet.ResourceRecords {
+			if rr.Value != nil {
+				cost.chars += len(*rr.Value)
+			}
+		}
+	}
+	return cost
+}
+
+// batchGroup is a single ChangeResourceRecordSets call's worth of changes,
+// tracking which hostnames contributed to it so callers can be told
+// per-hostname whether the call succeeded.
+type batchGroup struct {
+	changes   []types.Change
+	hostnames []Hostname
+	cost      changeCost
+}
+
+func (g *batchGroup) fits(c changeCost) bool {
+	return g.cost.ops+c.ops <= maxChangesPerBatch && g.cost.chars+c.chars <= maxBatchValueChars
+}
+
+func (g *batchGroup) add(hostname Hostname, change types.Change, c changeCost) {
+	g.changes = append(g.changes, change)
+	g.hostnames = append(g.hostnames, hostname)
+	g.cost.ops += c.ops
+	g.cost.chars += c.chars
+}
+
+type zoneBatches struct {
+	groups []*batchGroup
+}
+
+func (z *zoneBatches) add(hostname Hostname, change types.Change) {
+	cost := costOf(change)
+	var group *batchGroup
+	if n := len(z.groups); n > 0 && z.groups[n-1].fits(cost) {
+		group = z.groups[n-1]
+	} else {
+		group = &batchGroup{}
+		z.groups = append(z.groups, group)
+	}
+	group.add(hostname, change, cost)
+}
+
+// batcher accumulates pending Route53 changes across many hostnames,
+// grouping them per hosted zone and greedily packing each group into
+// batches that respect Route53's per-request limits, starting a new
+// batch whenever the next change would exceed either limit.
+type batcher struct {
+	zones map[ZoneID]*zoneBatches
+	order []ZoneID
+}
+
+func newBatcher() *batcher {
+	return &batcher{zones: make(map[ZoneID]*zoneBatches)}
+}
+
+func (b *batcher) add(zoneId ZoneID, hostname Hostname, changes ...types.Change) {
+	z, ok := b.zones[zoneId]
+	if !ok {
+		z = &zoneBatches{}
+		b.zones[zoneId] = z
+		b.order = append(b.order, zoneId)
+	}
+	for _, change := range changes {
+		z.add(hostname, change)
+	}
+}
+
+func hostnamesOf(hosts []Hostname) []string {
+	names := make([]string, len(hosts))
+	for i, h := range hosts {
+		names[i] = string(h)
+	}
+	return names
+}
+
+// mergeHostnameResult records result as hostname's result, unless hostname
+// already has a recorded failure and result is a success (a later failure
+// replaces an earlier one): a hostname's A/AAAA change and its TXT
+// "last updated" change can land in different batch groups when a zone
+// spans multiple batches, and a later group's success must never erase an
+// earlier group's recorded failure for the same hostname.
+func mergeHostnameResult(results map[Hostname]ApplyResult, hostname Hostname, result ApplyResult) {
+	if existing, ok := results[hostname]; ok && existing.Err != nil && result.Err == nil {
+		return
+	}
+	results[hostname] = result
+}
+
+// flush issues one ChangeResourceRecordSets call per accumulated batch and
+// returns the result (error nil on success, plus a response summary) for
+// every hostname added via add(), so callers can still notify per-hostname.
+func (b *batcher) flush(ctx context.Context, client route53.Client, commit bool) map[Hostname]ApplyResult {
+	results := make(map[Hostname]ApplyResult)
+	comment := "Unifi Updated IP Address"
+	for _, zoneId := range b.order {
+		resourceZoneId := string(zoneId)
+		for _, group := range b.zones[zoneId].groups {
+			input := route53.ChangeResourceRecordSetsInput{
+				ChangeBatch: &types.ChangeBatch{
+					Changes: group.changes,
+					Comment: &comment,
+				},
+				HostedZoneId: &resourceZoneId,
+			}
+			log.Debug().Interface("ChangeResourcesRecordSetInput", input).Send()
+
+			var result ApplyResult
+			if commit {
+				start := time.Now()
+				output, callErr := client.ChangeResourceRecordSets(ctx, &input)
+				route53ApiDuration.Observe(time.Since(start).Seconds())
+				if callErr != nil {
+					result.Err = callErr
+					result.Response = callErr.Error()
+					log.Error().Err(callErr).
+						Interface("ChangeResourcesRecordSetOutput", output).
+						Interface("ChangeResourcesRecordSetInput", input).
+						Msg("Error Updating Route53 RecordSets")
+				} else {
+					if output != nil && output.ChangeInfo != nil {
+						result.Response = fmt.Sprintf("ChangeInfo: Id=%s Status=%s",
+							aws.ToString(output.ChangeInfo.Id), output.ChangeInfo.Status)
+					}
+					log.Info().
+						Strs("hostnames", hostnamesOf(group.hostnames)).
+						Interface("ChangeResourcesRecordSetOutput", output).
+						Msg("RecordSet batch updated successfully.")
+				}
+			}
+			for _, hostname := range group.hostnames {
+				mergeHostnameResult(results, hostname, result)
+			}
+		}
+	}
+	return results
+}
+
+// recordCacheKey identifies a single Route53 record set.
+type recordCacheKey struct {
+	zoneId   ZoneID
+	hostname Hostname
+	rrType   types.RRType
+}
+
+type recordCacheEntry struct {
+	value   string
+	expires time.Time
+}
+
+// recordCache remembers the last-seen value of a record set in memory for
+// up to that host's configured TTL, so repeated UniFi pings with an
+// unchanged IP don't even need a ListResourceRecordSets round-trip.
+type recordCache struct {
+	mu      sync.Mutex
+	entries map[recordCacheKey]recordCacheEntry
+}
+
+func newRecordCache() *recordCache {
+	return &recordCache{entries: make(map[recordCacheKey]recordCacheEntry)}
+}
+
+func (c *recordCache) get(key recordCacheKey) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		return "", false
+	}
+	return entry.value, true
+}
+
+func (c *recordCache) set(key recordCacheKey, value string, ttl TTL) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = recordCacheEntry{value: value, expires: time.Now().Add(time.Duration(ttl) * time.Second)}
+}
+
+var globalRecordCache = newRecordCache()
+
+// lookupCurrentValue fetches the current value of (zoneId, hostname,
+// rrType) from Route53, returning "" if no matching record set exists.
+func lookupCurrentValue(ctx context.Context, client route53.Client, zoneId ZoneID, hostname Hostname, rrType types.RRType) (string, error) {
+	resourceZoneId := string(zoneId)
+	resourceName := string(hostname) + "."
+	output, err := client.ListResourceRecordSets(ctx, &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    &resourceZoneId,
+		StartRecordName: &resourceName,
+		StartRecordType: rrType,
+		MaxItems:        aws.Int32(1),
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(output.ResourceRecordSets) == 0 {
+		return "", nil
+	}
+	rrset := output.ResourceRecordSets[0]
+	if rrset.Name == nil || *rrset.Name != resourceName || rrset.Type != rrType || len(rrset.ResourceRecords) == 0 {
+		return "", nil
+	}
+	return *rrset.ResourceRecords[0].Value, nil
+}
+
+// needsUpdate reports whether (zoneId, hostname, rrType) needs to change to
+// reach newValue, consulting the in-memory cache before falling back to a
+// live Route53 lookup on a cache miss.
+func needsUpdate(ctx context.Context, client route53.Client, zoneId ZoneID, hostname Hostname, rrType types.RRType, newValue string, ttl TTL) bool {
+	key := recordCacheKey{zoneId: zoneId, hostname: hostname, rrType: rrType}
+	if cached, ok := globalRecordCache.get(key); ok {
+		return cached != newValue
+	}
+	current, err := lookupCurrentValue(ctx, client, zoneId, hostname, rrType)
+	if err != nil {
+		log.Warn().Err(err).Str("hostname", string(hostname)).Msg("Unable to look up current Route53 record, will update")
+		return true
+	}
+	globalRecordCache.set(key, current, ttl)
+	return current != newValue
+}
+
+// DesiredRecordType is an address family a Provider can upsert.
+type DesiredRecordType string
+
+const (
+	RecordTypeA    DesiredRecordType = "A"
+	RecordTypeAAAA DesiredRecordType = "AAAA"
+)
+
+// DesiredRecord is a single address record an UpdateRequest wants upserted.
+// Providers are free to attach their own bookkeeping records (e.g.
+// Route53's "last updated" TXT record) on top of what's requested here.
+type DesiredRecord struct {
+	Hostname Hostname
+	Type     DesiredRecordType
+	Value    string
+	TTL      TTL
+}
+
+// ApplyResult is the outcome of applying one hostname's records: Err is nil
+// on success, and Response carries a human-readable summary of the
+// provider's API response (or error) so /audit can show operators what the
+// provider actually said without tailing container logs.
+type ApplyResult struct {
+	Err      error
+	Response string
+}
+
+// Provider abstracts the DNS backend used to upsert records, so hosts
+// hosted across different providers can share the same UniFi custom-DDNS
+// webhook. ApplyRecords returns the result (error nil on success, plus a
+// response summary) for every hostname that actually required a change;
+// hostnames whose records were already up to date are omitted entirely.
+type Provider interface {
+	ApplyRecords(ctx context.Context, zone string, records []DesiredRecord, commit bool) map[Hostname]ApplyResult
+}
+
+func route53RRType(t DesiredRecordType) types.RRType {
+	if t == RecordTypeAAAA {
+		return types.RRTypeAaaa
+	}
+	return types.RRTypeA
+}
+
+// route53Provider is the Provider implementation backed by Route53,
+// reusing the change-diffing cache and batcher above.
+type route53Provider struct {
+	client route53.Client
+}
+
+func NewRoute53Provider(client route53.Client) *route53Provider {
+	return &route53Provider{client: client}
+}
+
+func (p *route53Provider) ApplyRecords(ctx context.Context, zone string, records []DesiredRecord, commit bool) map[Hostname]ApplyResult {
+	zoneId := ZoneID(zone)
+	b := newBatcher()
+	changedHosts := make(map[Hostname][]DesiredRecord)
+
+	for _, rec := range records {
+		rrType := route53RRType(rec.Type)
+		if !needsUpdate(ctx, p.client, zoneId, rec.Hostname, rrType, rec.Value, rec.TTL) {
+			continue
+		}
+		b.add(zoneId, rec.Hostname, makeResourceRecordChange(rec.Hostname, rrType, rec.TTL, rec.Value))
+		changedHosts[rec.Hostname] = append(changedHosts[rec.Hostname], rec)
+	}
+
+	// Record a "last updated" TXT note alongside any host whose address
+	// records actually changed.
+	for hostname, recs := range changedHosts {
+		lastUpdatedMsg := fmt.Sprintf("\"Last Updated: %s\"", time.Now().String())
+		b.add(zoneId, hostname, makeResourceRecordChange(hostname, types.RRTypeTxt, recs[0].TTL, lastUpdatedMsg))
+	}
+
+	results := b.flush(ctx, p.client, commit)
 	if commit {
-		output, err := client.ChangeResourceRecordSets(context.TODO(), &input)
+		for hostname, recs := range changedHosts {
+			if results[hostname].Err != nil {
+				continue
+			}
+			for _, rec := range recs {
+				globalRecordCache.set(recordCacheKey{zoneId, hostname, route53RRType(rec.Type)}, rec.Value, rec.TTL)
+			}
+		}
+	}
+	return results
+}
+
+// cloudflareProvider is the Provider implementation backed by Cloudflare's
+// DNS API. Cloudflare doesn't document the same per-request batch limits
+// as Route53, so each record is upserted with its own API call; records
+// whose existing content already matches are skipped.
+type cloudflareProvider struct {
+	api *cloudflare.API
+}
+
+func NewCloudflareProvider(config CloudflareConfig) (*cloudflareProvider, error) {
+	api, err := cloudflare.NewWithAPIToken(config.ApiToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize Cloudflare client: %w", err)
+	}
+	return &cloudflareProvider{api: api}, nil
+}
+
+func (p *cloudflareProvider) ApplyRecords(ctx context.Context, zone string, records []DesiredRecord, commit bool) map[Hostname]ApplyResult {
+	results := make(map[Hostname]ApplyResult)
+	rc := cloudflare.ZoneIdentifier(zone)
+	for _, rec := range records {
+		name := string(rec.Hostname)
+		rrType := string(rec.Type)
+		existing, _, err := p.api.ListDNSRecords(ctx, rc, cloudflare.ListDNSRecordsParams{Type: rrType, Name: name})
 		if err != nil {
-			log.Error().Err(err).
-				Interface("ChangeResourcesRecordSetOutput", output).
-				Interface("ChangeResourcesRecordSetInput", input).
-				Msg("Error Updating Route53 RecordSets")
-			return err
+			mergeHostnameResult(results, rec.Hostname, ApplyResult{Err: err, Response: err.Error()})
+			continue
+		}
+		if len(existing) > 0 && existing[0].Content == rec.Value {
+			continue
+		}
+		if !commit {
+			mergeHostnameResult(results, rec.Hostname, ApplyResult{})
+			continue
+		}
+		ttl := int(rec.TTL)
+		var record interface{}
+		if len(existing) == 0 {
+			record, err = p.api.CreateDNSRecord(ctx, rc, cloudflare.CreateDNSRecordParams{Type: rrType, Name: name, Content: rec.Value, TTL: ttl})
 		} else {
-			log.Info().
-				Str("hostname", string(hostname)).
-				Interface("ChangeResourcesRecordSetOutput", output).
-				Msg("RecordSet updated successfully.")
+			record, err = p.api.UpdateDNSRecord(ctx, rc, cloudflare.UpdateDNSRecordParams{ID: existing[0].ID, Type: rrType, Name: name, Content: rec.Value, TTL: ttl})
+		}
+		if err != nil {
+			mergeHostnameResult(results, rec.Hostname, ApplyResult{Err: err, Response: err.Error()})
+			continue
 		}
+		mergeHostnameResult(results, rec.Hostname, ApplyResult{Response: fmt.Sprintf("Cloudflare response: %+v", record)})
 	}
-	return nil
+	return results
 }
 
-func ProcessIpChange(client route53.Client, appConfig AppConfig, request UpdateRequest) {
-	ip, err := request.validateIp()
+func ProcessIpChange(providers map[string]Provider, notifications *Notifications, audit *auditLog, appConfig AppConfig, request UpdateRequest) {
+	recordFailure := func(result string, err error) {
+		updatesTotal.WithLabelValues(request.Host, result).Inc()
+		entry := AuditEntry{Host: request.Host, IP: request.IP, IPv6: request.IPv6, Commit: request.Commit, Result: result}
+		if err != nil {
+			entry.Error = err.Error()
+		}
+		audit.record(entry)
+	}
+
+	hostConfig, exists := appConfig.Records[request.Host]
+	if !exists {
+		log.Error().Interface("request", request).Msg("Hostname not found in config, ignoring")
+		recordFailure("unknown_host", nil)
+		return
+	}
+
+	providerName := hostConfig.Provider
+	if providerName == "" {
+		providerName = DefaultProvider
+	}
+	provider, exists := providers[providerName]
+	if !exists {
+		log.Error().Str("provider", providerName).Interface("request", request).Msg("Unknown DNS provider configured for host")
+		recordFailure("unknown_provider", nil)
+		return
+	}
+
+	ipv4, err := request.validateIPv4()
+	if err != nil {
+		log.Error().Interface("request", request).Err(err).Msg("Invalid IPv4 address specified")
+		recordFailure("invalid_ip", err)
+		return
+	}
+	ipv6, err := request.validateIPv6()
 	if err != nil {
-		log.Error().Interface("request", request).Err(err).Msg("Invalid IP specified")
+		log.Error().Interface("request", request).Err(err).Msg("Invalid IPv6 address specified")
+		recordFailure("invalid_ip", err)
 		return
 	}
 
-	if hostConfig, exists := appConfig.Records[request.Host]; exists {
-		err := UpdateRoute53Record(client, hostConfig, request.getHostname(), ip, request.Commit)
-		NotifyPushover(appConfig.Pushover, request.Host, err)
-		if err != nil {
-			// Process AWS request for this host, and additional hosts.
-			for _, additionalHost := range hostConfig.AdditionalHosts {
-				hostname := Hostname(additionalHost)
-				err := UpdateRoute53Record(client, hostConfig, hostname, ip, request.Commit)
-				if err != nil {
-					NotifyPushover(appConfig.Pushover, string(hostname), err)
+	if hostConfig.EnableA && ipv4 == nil {
+		if resolved, resolveErr := resolveOpenDNS(context.TODO(), "ip4"); resolveErr != nil {
+			log.Warn().Err(resolveErr).Msg("Unable to resolve public IPv4 address via OpenDNS")
+		} else {
+			ipv4 = resolved
+		}
+	}
+	if hostConfig.EnableAAAA && ipv6 == nil {
+		if resolved, resolveErr := resolveOpenDNS(context.TODO(), "ip6"); resolveErr != nil {
+			log.Warn().Err(resolveErr).Msg("Unable to resolve public IPv6 address via OpenDNS")
+		} else {
+			ipv6 = resolved
+		}
+	}
+
+	if !hostConfig.EnableA {
+		ipv4 = nil
+	}
+	if !hostConfig.EnableAAAA {
+		ipv6 = nil
+	}
+
+	if ipv4 == nil && ipv6 == nil {
+		log.Error().Interface("request", request).Msg("No IPv4 or IPv6 address available to update")
+		recordFailure("invalid_ip", nil)
+		return
+	}
+
+	ctx := context.TODO()
+	ttl := TTL(hostConfig.Ttl)
+
+	hostnames := make([]Hostname, 0, 1+len(hostConfig.AdditionalHosts))
+	hostnames = append(hostnames, request.getHostname())
+	for _, additionalHost := range hostConfig.AdditionalHosts {
+		hostnames = append(hostnames, Hostname(additionalHost))
+	}
+
+	records := make([]DesiredRecord, 0, len(hostnames)*2)
+	for _, hostname := range hostnames {
+		if ipv4 != nil {
+			records = append(records, DesiredRecord{Hostname: hostname, Type: RecordTypeA, Value: ipv4.String(), TTL: ttl})
+		}
+		if ipv6 != nil {
+			records = append(records, DesiredRecord{Hostname: hostname, Type: RecordTypeAAAA, Value: ipv6.String(), TTL: ttl})
+		}
+	}
+
+	results := provider.ApplyRecords(ctx, hostConfig.ZoneId, records, request.Commit)
+	for _, hostname := range hostnames {
+		entry := AuditEntry{Host: string(hostname), IP: request.IP, IPv6: request.IPv6, Commit: request.Commit, Provider: providerName}
+
+		result, attempted := results[hostname]
+		if !attempted {
+			entry.Result = "noop"
+			updatesTotal.WithLabelValues(string(hostname), "noop").Inc()
+			audit.record(entry)
+			log.Info().Str("hostname", string(hostname)).Msg("IP unchanged, skipping DNS update")
+			continue
+		}
+		entry.ProviderResponse = result.Response
+
+		newValue := ""
+		if ipv4 != nil {
+			newValue += ipv4.String()
+		}
+		if ipv6 != nil {
+			newValue += "," + ipv6.String()
+		}
+		notifications.notify(ctx, string(hostname), newValue, result.Err)
+		if result.Err != nil {
+			entry.Result = "aws_error"
+			entry.Error = result.Err.Error()
+			updatesTotal.WithLabelValues(string(hostname), "aws_error").Inc()
+		} else {
+			entry.Result = "success"
+			updatesTotal.WithLabelValues(string(hostname), "success").Inc()
+			if request.Commit {
+				lastUpdateTimestamp.WithLabelValues(string(hostname)).SetToCurrentTime()
+				if ipv4 != nil {
+					currentIPInfoTracker.set(string(hostname), RecordTypeA, ipv4.String())
+				}
+				if ipv6 != nil {
+					currentIPInfoTracker.set(string(hostname), RecordTypeAAAA, ipv6.String())
 				}
 			}
 		}
-	} else {
-		log.Error().Interface("request", request).Msg("Hostname not found in config, ignoring")
+		audit.record(entry)
+	}
+}
+
+// validateBasicAuth checks the request's HTTP Basic Auth credentials
+// against config. Basic Auth is optional: if no username is configured,
+// every request is allowed through.
+func validateBasicAuth(req *http.Request, config BasicAuthConfig) bool {
+	if config.Username == "" {
+		return true
+	}
+	username, password, ok := req.BasicAuth()
+	if !ok || subtle.ConstantTimeCompare([]byte(username), []byte(config.Username)) != 1 {
+		return false
+	}
+	if config.PasswordHash != "" {
+		return bcrypt.CompareHashAndPassword([]byte(config.PasswordHash), []byte(password)) == nil
+	}
+	return config.Password != "" && subtle.ConstantTimeCompare([]byte(password), []byte(config.Password)) == 1
+}
+
+// validateHostToken checks the per-host bearer token, accepted either as
+// an "Authorization: Bearer <token>" header or a "token" form value. A
+// host with no token configured doesn't require one.
+func validateHostToken(req *http.Request, token string) bool {
+	if token == "" {
+		return true
+	}
+	provided := req.FormValue("token")
+	if provided == "" {
+		if authHeader := req.Header.Get("Authorization"); strings.HasPrefix(authHeader, "Bearer ") {
+			provided = strings.TrimPrefix(authHeader, "Bearer ")
+		}
+	}
+	return subtle.ConstantTimeCompare([]byte(provided), []byte(token)) == 1
+}
+
+// parseTrustedProxies parses the configured trustedProxies CIDRs, logging
+// and skipping any that don't parse rather than failing startup.
+func parseTrustedProxies(cidrs []string) []*net.IPNet {
+	nets := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			log.Warn().Str("cidr", cidr).Err(err).Msg("Invalid trustedProxies CIDR, ignoring")
+			continue
+		}
+		nets = append(nets, ipNet)
+	}
+	return nets
+}
+
+func isTrustedProxy(ip net.IP, trustedProxies []*net.IPNet) bool {
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range trustedProxies {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// clientIP returns the request's real client IP, honoring X-Forwarded-For
+// only when the immediate peer is a configured trusted proxy.
+func clientIP(req *http.Request, trustedProxies []*net.IPNet) string {
+	remoteAddr := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(remoteAddr); err == nil {
+		remoteAddr = host
+	}
+	if !isTrustedProxy(net.ParseIP(remoteAddr), trustedProxies) {
+		return remoteAddr
+	}
+	if forwardedFor := req.Header.Get("X-Forwarded-For"); forwardedFor != "" {
+		return strings.TrimSpace(strings.Split(forwardedFor, ",")[0])
 	}
+	return remoteAddr
 }
 
 func HealthCheck(w http.ResponseWriter, _ *http.Request) {
@@ -230,6 +1117,26 @@ func LoadAppConfig() AppConfig {
 		appConfig.App.Port = DEFAULT_PORT
 	}
 
+	// Hosts that don't explicitly configure either record family keep the
+	// historical A-only behavior.
+	for host, hostConfig := range appConfig.Records {
+		if !hostConfig.EnableA && !hostConfig.EnableAAAA {
+			hostConfig.EnableA = true
+			appConfig.Records[host] = hostConfig
+		}
+	}
+
+	// Configs that still use the legacy top-level `pushover:` block and
+	// haven't migrated to `notifications.channels` keep notifying via it.
+	if len(appConfig.Notifications.Channels) == 0 && appConfig.Pushover.ApiToken != "" {
+		appConfig.Notifications.Channels = append(appConfig.Notifications.Channels, NotificationChannelConfig{
+			Type:     "pushover",
+			Severity: "always",
+			ApiToken: appConfig.Pushover.ApiToken,
+			UserKey:  appConfig.Pushover.RecipientKey,
+		})
+	}
+
 	log.Debug().Interface("config", appConfig).Msg("Loaded App Config")
 	return appConfig
 }
@@ -271,23 +1178,95 @@ func main() {
 	awsConfig := LoadAwsConfig()
 	r53Client := InitRoute53Client(awsConfig)
 
+	providers := map[string]Provider{
+		"route53": NewRoute53Provider(r53Client),
+	}
+	if appConfig.Cloudflare.ApiToken != "" {
+		cfProvider, err := NewCloudflareProvider(appConfig.Cloudflare)
+		if err != nil {
+			log.Error().Err(err).Msg("Failed to initialize Cloudflare provider")
+		} else {
+			providers["cloudflare"] = cfProvider
+		}
+	}
+
+	notifications := &Notifications{
+		entries:      BuildNotifiers(appConfig.Notifications),
+		dedupe:       newNotificationDedupe(),
+		dedupeWindow: time.Duration(appConfig.Notifications.DedupeWindowSeconds) * time.Second,
+	}
+
+	trustedProxies := parseTrustedProxies(appConfig.App.TrustedProxies)
+
+	auditLogSize := appConfig.App.AuditLogSize
+	if auditLogSize <= 0 {
+		auditLogSize = DEFAULT_AUDIT_LOG_SIZE
+	}
+	audit := newAuditLog(auditLogSize)
+
 	nicUpdateHandler := func(w http.ResponseWriter, req *http.Request) {
+		callerIP := clientIP(req, trustedProxies)
+
+		if !validateBasicAuth(req, appConfig.App.BasicAuth) {
+			log.Warn().Str("remoteAddr", callerIP).Msg("Rejected /nic/update request: invalid credentials")
+			w.Header().Set("WWW-Authenticate", `Basic realm="unifi-r53-dns"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
 		req.ParseForm()
+		hostname := req.FormValue("hostname")
+		if !validateHostToken(req, appConfig.Records[hostname].Token) {
+			log.Warn().Str("remoteAddr", callerIP).Str("hostname", hostname).Msg("Rejected /nic/update request: invalid host token")
+			http.Error(w, "forbidden", http.StatusForbidden)
+			return
+		}
+
 		commitChanges := true
 		if _, present := req.Form["commit"]; present {
 			commitChanges, _ = strconv.ParseBool(req.FormValue("commit"))
 		}
 		updateRequest := UpdateRequest{
-			Host:   req.FormValue("hostname"),
+			Host:   hostname,
 			IP:     req.FormValue("ip"),
+			IPv6:   req.FormValue("ipv6"),
 			Commit: commitChanges,
 		}
 		log.Info().Interface("request", &updateRequest).Msg("Received Update Request")
-		ProcessIpChange(r53Client, appConfig, updateRequest)
+		ProcessIpChange(providers, notifications, audit, appConfig, updateRequest)
+	}
+
+	auditHandler := func(w http.ResponseWriter, req *http.Request) {
+		callerIP := clientIP(req, trustedProxies)
+		if !validateBasicAuth(req, appConfig.App.BasicAuth) {
+			log.Warn().Str("remoteAddr", callerIP).Msg("Rejected /audit request: invalid credentials")
+			w.Header().Set("WWW-Authenticate", `Basic realm="unifi-r53-dns"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(audit.snapshot())
+	}
+
+	metricsHandler := func(w http.ResponseWriter, req *http.Request) {
+		callerIP := clientIP(req, trustedProxies)
+		if !validateBasicAuth(req, appConfig.App.BasicAuth) {
+			log.Warn().Str("remoteAddr", callerIP).Msg("Rejected /metrics request: invalid credentials")
+			w.Header().Set("WWW-Authenticate", `Basic realm="unifi-r53-dns"`)
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		promhttp.Handler().ServeHTTP(w, req)
 	}
 
 	http.HandleFunc("/nic/update", nicUpdateHandler)
 	http.HandleFunc("/health-check", HealthCheck)
+	http.HandleFunc("/audit", auditHandler)
+	// /metrics carries unifi_r53_current_ip_info (host, ip) and host labels
+	// on unifi_r53_updates_total/unifi_r53_last_update_timestamp_seconds —
+	// the same host->IP mapping /audit exposes — so it requires the same
+	// Basic Auth credentials rather than being left open for scraping.
+	http.HandleFunc("/metrics", metricsHandler)
 
 	log.Info().Msg("Application Initialized")
 	http.ListenAndServe(fmt.Sprintf(":%d", appConfig.App.Port), nil)
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+func changeWithValue(hostname Hostname, value string) types.Change {
+	return makeResourceRecordChange(hostname, types.RRTypeA, TTL(300), value)
+}
+
+func TestZoneBatchesPacksChangesIntoOneGroupWhenUnderLimits(t *testing.T) {
+	z := &zoneBatches{}
+	z.add("a.example.com", changeWithValue("a.example.com", "10.0.0.1"))
+	z.add("b.example.com", changeWithValue("b.example.com", "10.0.0.2"))
+
+	if len(z.groups) != 1 {
+		t.Fatalf("expected 1 group, got %d", len(z.groups))
+	}
+	if len(z.groups[0].changes) != 2 {
+		t.Fatalf("expected 2 changes in the group, got %d", len(z.groups[0].changes))
+	}
+}
+
+func TestZoneBatchesStartsNewGroupWhenOpsLimitWouldBeExceeded(t *testing.T) {
+	z := &zoneBatches{}
+	// Each UPSERT costs 2 ops; maxChangesPerBatch/2 changes exactly fill
+	// one group, so one more must overflow into a second group.
+	for i := 0; i < maxChangesPerBatch/2; i++ {
+		z.add("host.example.com", changeWithValue("host.example.com", "10.0.0.1"))
+	}
+	if len(z.groups) != 1 {
+		t.Fatalf("expected 1 group before overflow, got %d", len(z.groups))
+	}
+
+	z.add("host.example.com", changeWithValue("host.example.com", "10.0.0.1"))
+
+	if len(z.groups) != 2 {
+		t.Fatalf("expected overflow into a 2nd group, got %d", len(z.groups))
+	}
+	if len(z.groups[1].changes) != 1 {
+		t.Fatalf("expected the overflowing change alone in the 2nd group, got %d", len(z.groups[1].changes))
+	}
+}
+
+func TestZoneBatchesStartsNewGroupWhenCharLimitWouldBeExceeded(t *testing.T) {
+	z := &zoneBatches{}
+	longValue := strings.Repeat("x", maxBatchValueChars)
+	z.add("a.example.com", changeWithValue("a.example.com", longValue))
+	if len(z.groups) != 1 {
+		t.Fatalf("expected 1 group, got %d", len(z.groups))
+	}
+
+	z.add("b.example.com", changeWithValue("b.example.com", "10.0.0.1"))
+
+	if len(z.groups) != 2 {
+		t.Fatalf("expected overflow into a 2nd group, got %d", len(z.groups))
+	}
+}
+
+func TestBatcherGroupsByZone(t *testing.T) {
+	b := newBatcher()
+	b.add("zone-1", "a.example.com", changeWithValue("a.example.com", "10.0.0.1"))
+	b.add("zone-2", "b.example.com", changeWithValue("b.example.com", "10.0.0.2"))
+
+	if len(b.order) != 2 {
+		t.Fatalf("expected 2 zones, got %d", len(b.order))
+	}
+	if len(b.zones["zone-1"].groups) != 1 || len(b.zones["zone-2"].groups) != 1 {
+		t.Fatalf("expected each zone to have its own single group")
+	}
+}
+
+func TestMergeHostnameResultPreservesEarlierFailure(t *testing.T) {
+	results := make(map[Hostname]ApplyResult)
+	boom := errors.New("boom")
+
+	mergeHostnameResult(results, "host.example.com", ApplyResult{Err: boom, Response: "group 1 failed"})
+	mergeHostnameResult(results, "host.example.com", ApplyResult{Response: "group 2 ok"})
+
+	got := results["host.example.com"]
+	if got.Err != boom || got.Response != "group 1 failed" {
+		t.Fatalf("expected earlier failure to survive a later success, got %+v", got)
+	}
+}
+
+func TestMergeHostnameResultRecordsLaterFailure(t *testing.T) {
+	results := make(map[Hostname]ApplyResult)
+	boom := errors.New("boom")
+
+	mergeHostnameResult(results, "host.example.com", ApplyResult{Response: "group 1 ok"})
+	mergeHostnameResult(results, "host.example.com", ApplyResult{Err: boom, Response: "group 2 failed"})
+
+	got := results["host.example.com"]
+	if got.Err != boom || got.Response != "group 2 failed" {
+		t.Fatalf("expected later failure to be recorded, got %+v", got)
+	}
+}
+
+func TestAuditLogSnapshotBeforeWraparound(t *testing.T) {
+	a := newAuditLog(3)
+	a.record(AuditEntry{Host: "a"})
+	a.record(AuditEntry{Host: "b"})
+
+	snap := a.snapshot()
+	if len(snap) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(snap))
+	}
+	if snap[0].Host != "a" || snap[1].Host != "b" {
+		t.Fatalf("expected chronological order [a b], got %v", snap)
+	}
+}
+
+func TestAuditLogSnapshotAfterWraparound(t *testing.T) {
+	a := newAuditLog(3)
+	a.record(AuditEntry{Host: "a"})
+	a.record(AuditEntry{Host: "b"})
+	a.record(AuditEntry{Host: "c"})
+	a.record(AuditEntry{Host: "d"})
+
+	snap := a.snapshot()
+	if len(snap) != 3 {
+		t.Fatalf("expected capacity-bounded 3 entries, got %d", len(snap))
+	}
+	got := []string{snap[0].Host, snap[1].Host, snap[2].Host}
+	want := []string{"b", "c", "d"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected chronological order %v after wraparound, got %v", want, got)
+		}
+	}
+}
+
+func TestAuditLogRecordIsNoopWithZeroCapacity(t *testing.T) {
+	a := newAuditLog(0)
+	a.record(AuditEntry{Host: "a"})
+	if snap := a.snapshot(); len(snap) != 0 {
+		t.Fatalf("expected no entries with zero capacity, got %v", snap)
+	}
+}
+
+func TestNotificationDedupeSuppressesWithinWindow(t *testing.T) {
+	d := newNotificationDedupe()
+	window := 50 * time.Millisecond
+
+	if !d.allow("host", "IP Updated", window) {
+		t.Fatal("expected first notification to be allowed")
+	}
+	if d.allow("host", "IP Updated", window) {
+		t.Fatal("expected repeat notification within the window to be suppressed")
+	}
+}
+
+func TestNotificationDedupeAllowsAfterWindowElapses(t *testing.T) {
+	d := newNotificationDedupe()
+	window := 10 * time.Millisecond
+
+	if !d.allow("host", "IP Updated", window) {
+		t.Fatal("expected first notification to be allowed")
+	}
+	time.Sleep(2 * window)
+	if !d.allow("host", "IP Updated", window) {
+		t.Fatal("expected notification to be allowed again once the window elapses")
+	}
+}
+
+func TestNotificationDedupeDistinguishesMessagesPerHost(t *testing.T) {
+	d := newNotificationDedupe()
+	window := time.Minute
+
+	if !d.allow("host", "IP Updated", window) {
+		t.Fatal("expected first message to be allowed")
+	}
+	if !d.allow("host", "Error: IP Update Failed", window) {
+		t.Fatal("expected a different message for the same host to be allowed")
+	}
+	if !d.allow("other-host", "IP Updated", window) {
+		t.Fatal("expected the same message for a different host to be allowed")
+	}
+}
+
+func TestNotificationDedupeDisabledWithZeroWindow(t *testing.T) {
+	d := newNotificationDedupe()
+	if !d.allow("host", "IP Updated", 0) {
+		t.Fatal("expected dedupe to be a no-op when window is zero")
+	}
+	if !d.allow("host", "IP Updated", 0) {
+		t.Fatal("expected dedupe to remain a no-op on repeat calls")
+	}
+}